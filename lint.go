@@ -62,6 +62,7 @@ var Funcs = map[string]lint.Func{
 	"SA4010": CheckIneffectiveAppend,
 	"SA4011": CheckScopedBreak,
 	"SA4012": CheckNaNComparison,
+	"SA4013": CheckExcessiveShift,
 
 	"SA5000": CheckNilMaps,
 	"SA5001": CheckEarlyDefer,
@@ -71,6 +72,8 @@ var Funcs = map[string]lint.Func{
 	"SA5005": CheckCyclicFinalizer,
 	"SA5006": CheckSliceOutOfBounds,
 	"SA5007": CheckInfiniteRecursion,
+	"SA5008": CheckConversionOverflow,
+	"SA5009": CheckIntegerDivisionByZero,
 
 	"SA9000": CheckDubiousSyncPoolPointers,
 	"SA9001": CheckDubiousDeferInChannelRangeLoop,
@@ -1902,7 +1905,47 @@ func CheckCyclicFinalizer(f *lint.File) {
 	f.Walk(fn)
 }
 
+func constInt(v ssa.Value) (int64, bool) {
+	c, ok := v.(*ssa.Const)
+	if !ok || c.Value == nil || c.Value.Kind() != constant.Int {
+		return 0, false
+	}
+	return constant.Int64Val(c.Value)
+}
+
 func CheckSliceOutOfBounds(f *lint.File) {
+	checkStringSlice := func(sl *ssa.Slice) {
+		c, ok := sl.X.(*ssa.Const)
+		if !ok || c.Value == nil || c.Value.Kind() != constant.String {
+			return
+		}
+		n := int64(len(constant.StringVal(c.Value)))
+		low, okLow := int64(0), true
+		if sl.Low != nil {
+			low, okLow = constInt(sl.Low)
+		}
+		high, okHigh := n, true
+		if sl.High != nil {
+			high, okHigh = constInt(sl.High)
+		}
+		bad := (okLow && (low < 0 || low > n)) ||
+			(okHigh && (high < 0 || high > n)) ||
+			(okLow && okHigh && low > high)
+		if !bad || guardedByConstCondition(sl) {
+			return
+		}
+		f.Errorf(sl, "slice bounds out of range")
+	}
+	report := func(ins ssa.Instruction) {
+		if !guardedByConstCondition(ins) {
+			f.Errorf(ins, "index out of bounds")
+		}
+	}
+	checkNegativeIndex := func(ins ssa.Instruction, index ssa.Value) {
+		if idx, ok := constInt(index); ok && idx < 0 {
+			report(ins)
+		}
+	}
 	fn := func(node ast.Node) bool {
 		fn, ok := node.(*ast.FuncDecl)
 		if !ok {
@@ -1914,6 +1957,20 @@ func CheckSliceOutOfBounds(f *lint.File) {
 		}
 		for _, block := range ssafn.Blocks {
 			for _, ins := range block.Instrs {
+				switch ins := ins.(type) {
+				case *ssa.Slice:
+					checkStringSlice(ins)
+					continue
+				case *ssa.Lookup:
+					// Lookups in maps have keys, not indices
+					if _, ok := ins.X.Type().Underlying().(*types.Basic); ok {
+						checkNegativeIndex(ins, ins.Index)
+					}
+					continue
+				case *ssa.Index:
+					checkNegativeIndex(ins, ins.Index)
+					continue
+				}
 				ia, ok := ins.(*ssa.IndexAddr)
 				if !ok {
 					continue
@@ -1923,10 +1980,14 @@ func CheckSliceOutOfBounds(f *lint.File) {
 					continue
 				}
 				idx, _ := constant.Int64Val(ic.Value)
+				if idx < 0 {
+					report(ia)
+					continue
+				}
 				switch x := ia.X.(type) {
 				case *ssa.Const:
 					if x.Value == nil {
-						f.Errorf(ia, "index out of bounds")
+						report(ia)
 					}
 				case *ssa.Slice:
 					high := int64(-1)
@@ -1948,7 +2009,7 @@ func CheckSliceOutOfBounds(f *lint.File) {
 						high, _ = constant.Int64Val(c.Value)
 					}
 					if idx >= high {
-						f.Errorf(ia, "index out of bounds")
+						report(ia)
 					}
 				}
 			}
@@ -1999,6 +2060,53 @@ func CheckDeferLock(f *lint.File) {
 	f.Walk(fn)
 }
 
+func CheckExcessiveShift(f *lint.File) {
+	fn := func(node ast.Node) bool {
+		var x, y ast.Expr
+		switch node := node.(type) {
+		case *ast.BinaryExpr:
+			if node.Op != token.SHL && node.Op != token.SHR {
+				return true
+			}
+			x, y = node.X, node.Y
+		case *ast.AssignStmt:
+			if node.Tok != token.SHL_ASSIGN && node.Tok != token.SHR_ASSIGN {
+				return true
+			}
+			x, y = node.Lhs[0], node.Rhs[0]
+		default:
+			return true
+		}
+		// Shifts of constants are already checked by the compiler
+		if f.Pkg.TypesInfo.Types[x].Value != nil {
+			return true
+		}
+		T := f.Pkg.TypesInfo.TypeOf(x)
+		if T == nil {
+			return true
+		}
+		basic, ok := T.Underlying().(*types.Basic)
+		if !ok {
+			return true
+		}
+		bits, ok := intBits(basic)
+		if !ok {
+			return true
+		}
+		k := f.Pkg.TypesInfo.Types[y].Value
+		if k == nil {
+			return true
+		}
+		n, ok := constant.Uint64Val(constant.ToInt(k))
+		if !ok || n < uint64(bits) {
+			return true
+		}
+		f.Errorf(node, "%s (%d bits) too small for shift of %d", f.Render(x), bits, n)
+		return true
+	}
+	f.Walk(fn)
+}
+
 func CheckNaNComparison(f *lint.File) {
 	isNaN := func(x ast.Expr) bool {
 		call, ok := x.(*ast.CallExpr)
@@ -2071,6 +2179,179 @@ func CheckInfiniteRecursion(f *lint.File) {
 	f.Walk(fn)
 }
 
+// intBits returns the size in bits of an integer type. The
+// platform-dependent types are assumed to be 64 bits wide, the largest
+// size they can have, so that checks using intBits only flag values
+// that are out of range on every platform.
+func intBits(basic *types.Basic) (bits uint, ok bool) {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return 8, true
+	case types.Int16, types.Uint16:
+		return 16, true
+	case types.Int32, types.Uint32:
+		return 32, true
+	case types.Int64, types.Uint64, types.Int, types.Uint, types.Uintptr:
+		return 64, true
+	}
+	return 0, false
+}
+
+func CheckConversionOverflow(f *lint.File) {
+	fn := func(node ast.Node) bool {
+		fn, ok := node.(*ast.FuncDecl)
+		if !ok {
+			return true
+		}
+		ssafn := f.EnclosingSSAFunction(fn)
+		if ssafn == nil {
+			return true
+		}
+		for _, block := range ssafn.Blocks {
+			for _, ins := range block.Instrs {
+				conv, ok := ins.(*ssa.Convert)
+				if !ok {
+					continue
+				}
+				c, ok := conv.X.(*ssa.Const)
+				if !ok || c.Value == nil {
+					continue
+				}
+				src, ok := c.Type().Underlying().(*types.Basic)
+				if !ok || (src.Info()&types.IsInteger) == 0 {
+					continue
+				}
+				dst, ok := conv.Type().Underlying().(*types.Basic)
+				if !ok {
+					continue
+				}
+				bits, ok := intBits(dst)
+				if !ok {
+					continue
+				}
+				srcBits, _ := intBits(src)
+				one := constant.MakeInt64(1)
+				var lo, hi constant.Value
+				if (dst.Info() & types.IsUnsigned) != 0 {
+					lo = constant.MakeInt64(0)
+					hi = constant.Shift(one, token.SHL, bits)
+				} else {
+					lo = constant.UnaryOp(token.SUB, constant.Shift(one, token.SHL, bits-1), 0)
+					hi = constant.Shift(one, token.SHL, bits-1)
+				}
+				// Converting between signed and unsigned types of the
+				// same size is a common way of reinterpreting bits, so
+				// allow values that fit into either.
+				if srcBits == bits {
+					lo = constant.UnaryOp(token.SUB, constant.Shift(one, token.SHL, bits-1), 0)
+					hi = constant.Shift(one, token.SHL, bits)
+				}
+				if constant.Compare(c.Value, token.GEQ, lo) && constant.Compare(c.Value, token.LSS, hi) {
+					continue
+				}
+				if guardedByConstCondition(conv) {
+					continue
+				}
+				f.Errorf(conv, "conversion of %s to %s always overflows", c.Value,
+					types.TypeString(conv.Type(), types.RelativeTo(f.Pkg.SSAPkg.Pkg)))
+			}
+		}
+		return true
+	}
+	f.Walk(fn)
+}
+
+// guardedByConstCondition reports whether ins is in the branch of an
+// if statement that can never be taken, because its condition is a
+// constant or compares two constants. go/ssa doesn't prune such
+// branches, so code behind debug toggles and the like would otherwise
+// be flagged even though it never runs.
+func guardedByConstCondition(ins ssa.Instruction) bool {
+	block := ins.Block()
+	for dom := block.Idom(); dom != nil; dom = dom.Idom() {
+		if len(dom.Instrs) == 0 {
+			continue
+		}
+		cond, ok := dom.Instrs[len(dom.Instrs)-1].(*ssa.If)
+		if !ok {
+			continue
+		}
+		var val constant.Value
+		switch c := cond.Cond.(type) {
+		case *ssa.Const:
+			val = c.Value
+		case *ssa.BinOp:
+			switch c.Op {
+			case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			default:
+				continue
+			}
+			x, okX := c.X.(*ssa.Const)
+			y, okY := c.Y.(*ssa.Const)
+			if !okX || !okY || x.Value == nil || y.Value == nil {
+				continue
+			}
+			val = constant.MakeBool(constant.Compare(x.Value, c.Op, y.Value))
+		default:
+			continue
+		}
+		if val == nil || val.Kind() != constant.Bool {
+			continue
+		}
+		// Succs[0] is taken if the condition is true, Succs[1] if
+		// it is false
+		dead := dom.Succs[0]
+		if constant.BoolVal(val) {
+			dead = dom.Succs[1]
+		}
+		if len(dead.Preds) == 1 && dead.Dominates(block) {
+			return true
+		}
+	}
+	return false
+}
+
+func CheckIntegerDivisionByZero(f *lint.File) {
+	fn := func(node ast.Node) bool {
+		fn, ok := node.(*ast.FuncDecl)
+		if !ok {
+			return true
+		}
+		ssafn := f.EnclosingSSAFunction(fn)
+		if ssafn == nil {
+			return true
+		}
+		for _, block := range ssafn.Blocks {
+			for _, ins := range block.Instrs {
+				binop, ok := ins.(*ssa.BinOp)
+				if !ok {
+					continue
+				}
+				if binop.Op != token.QUO && binop.Op != token.REM {
+					continue
+				}
+				basic, ok := binop.Type().Underlying().(*types.Basic)
+				if !ok || (basic.Info()&types.IsInteger) == 0 {
+					continue
+				}
+				c, ok := binop.Y.(*ssa.Const)
+				if !ok || c.Value == nil {
+					continue
+				}
+				if constant.Sign(c.Value) != 0 {
+					continue
+				}
+				if guardedByConstCondition(binop) {
+					continue
+				}
+				f.Errorf(binop, "integer division by zero")
+			}
+		}
+		return true
+	}
+	f.Walk(fn)
+}
+
 func isFunctionCallName(f *lint.File, node ast.Node, name string) bool {
 	call, ok := node.(*ast.CallExpr)
 	if !ok {
@@ -0,0 +1,46 @@
+package pkg
+
+func fn1() {
+	x := 300
+	_ = uint8(x) // MATCH /conversion of 300 to uint8 always overflows/
+
+	y := 255
+	_ = uint8(y)
+}
+
+func fn2() {
+	var x int32 = -1
+	_ = uint32(x)
+
+	var y int64 = 1 << 40
+	_ = int32(y) // MATCH /always overflows/
+}
+
+func fn3(x int) {
+	_ = uint8(x)
+}
+
+func fn4() {
+	var x int64 = 200
+	_ = int8(x) // MATCH /conversion of 200 to int8 always overflows/
+
+	y := -1
+	_ = uint8(y) // MATCH /conversion of -1 to uint8 always overflows/
+
+	var z uint8 = 200
+	_ = int8(z)
+}
+
+func fn5() {
+	x := 300
+	if x <= 255 {
+		_ = uint8(x)
+	}
+}
+
+type T uint8
+
+func fn6() {
+	x := 300
+	_ = T(x) // MATCH /conversion of 300 to T always overflows/
+}
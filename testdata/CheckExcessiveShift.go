@@ -0,0 +1,18 @@
+package pkg
+
+func fn1() {
+	var x int8
+	_ = x << 10 // MATCH /x \(8 bits\) too small for shift of 10/
+	_ = x << 3
+	_ = x >> 8 // MATCH /too small for shift of 8/
+	x <<= 8    // MATCH /too small for shift of 8/
+
+	var y uint64
+	_ = y << 63
+	_ = y << 64 // MATCH /too small for shift of 64/
+}
+
+func fn2(x int8, n uint) {
+	_ = x << n
+	_ = 1 << n
+}
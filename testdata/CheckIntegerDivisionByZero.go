@@ -0,0 +1,47 @@
+package pkg
+
+func fn1(a int) {
+	x := 2 - 2
+	_ = a / x // MATCH /integer division by zero/
+	_ = a % x // MATCH /integer division by zero/
+}
+
+func fn2(a, b int) {
+	_ = a / b
+	if b == 0 {
+		b = 1
+	}
+	_ = a % b
+}
+
+func fn3(a float64) {
+	x := 0.0
+	_ = a / x
+}
+
+func fn4(a int) {
+	n := 0
+	if n != 0 {
+		_ = a / n
+	}
+
+	debug := false
+	if debug {
+		_ = a % n
+	}
+}
+
+func fn5(a int) {
+	n := 0
+	if n == 0 {
+		_ = a / n // MATCH /integer division by zero/
+	}
+}
+
+func fn6(a int) {
+	n := 0
+	if n != 0 {
+		return
+	}
+	_ = a / n // MATCH /integer division by zero/
+}
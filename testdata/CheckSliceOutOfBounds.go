@@ -49,5 +49,67 @@ func fn9() {
 	s[0] = 1
 }
 
-func fn(int)     {}
-func ptr(*[]int) {}
+func fn10(s []int) {
+	i := -1
+	s[i] = 0 // MATCH /index out of bounds/
+}
+
+func fn11(s []int, i int) {
+	s[i-1] = 0
+}
+
+func fn12(s []int) {
+	i := -1
+	if i >= 0 {
+		s[i] = 0
+	}
+}
+
+func fn13() {
+	s := "abc"
+	_ = s[:5] // MATCH /slice bounds out of range/
+	_ = s[1:3]
+
+	low, high := 2, 1
+	_ = s[low:high] // MATCH /slice bounds out of range/
+}
+
+func fn14(s string) {
+	_ = s[:5]
+}
+
+func fn15(j int) {
+	s := "abc"
+	_ = s[5:j] // MATCH /slice bounds out of range/
+
+	i := -1
+	_ = s[i:] // MATCH /slice bounds out of range/
+	_ = s[:i] // MATCH /slice bounds out of range/
+
+	if i >= 0 {
+		_ = s[i:]
+	}
+}
+
+func fn16() {
+	str := "abc"
+	i := -1
+	_ = str[i]   // MATCH /index out of bounds/
+	_ = arr()[i] // MATCH /index out of bounds/
+
+	m := map[int]int{}
+	_ = m[i]
+}
+
+func fn17() {
+	s := make([]int, 3)
+	i := 5
+	if i < 3 {
+		s[i] = 0
+	}
+	s[i] = 0 // MATCH /index out of bounds/
+}
+
+func fn(int)      {}
+func ptr(*[]int)  {}
+func arr() [3]int { return [3]int{} }